@@ -175,3 +175,29 @@ func TestSymlinkError(t *testing.T) {
 	_, err := NewFs("local", "/", m)
 	assert.Equal(t, errLinksAndCopyLinks, err)
 }
+
+func TestHashAdler32(t *testing.T) {
+	ctx := context.Background()
+	r := fstest.NewRun(t)
+	defer r.Finalise()
+	const want = "0d170218"
+	r.WriteFile("adler32.txt", "0123456789\n", time.Now())
+	assert.True(t, r.Flocal.Hashes().Contains(hash.Adler32))
+
+	// Check the hash can be read directly
+	o, err := r.Flocal.NewObject(ctx, "adler32.txt")
+	require.NoError(t, err)
+	sum, err := o.Hash(ctx, hash.Adler32)
+	require.NoError(t, err)
+	assert.Equal(t, want, sum)
+
+	// Check the hash is accumulated while reading if asked for
+	o, err = r.Flocal.NewObject(ctx, "adler32.txt")
+	require.NoError(t, err)
+	in, err := o.Open(ctx, &fs.HashesOption{Hashes: hash.NewHashSet(hash.Adler32)})
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(in)
+	require.NoError(t, err)
+	require.NoError(t, in.Close())
+	assert.Equal(t, map[hash.Type]string{hash.Adler32: want}, o.(*Object).hashes)
+}
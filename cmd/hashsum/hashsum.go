@@ -43,6 +43,11 @@ Run without a hash to see the list of supported hashes, eg
 Then
 
     $ rclone hashsum MD5 remote:path
+
+The hash name is matched case insensitively and any "-" may be left
+out, so this will produce Adler-32 sums of a local directory
+
+    $ rclone hashsum adler32 /path/to/dir
 `,
 	RunE: func(command *cobra.Command, args []string) error {
 		cmd.CheckArgs(0, 2, command, args)
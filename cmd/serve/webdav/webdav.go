@@ -3,8 +3,11 @@ package webdav
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/hex"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -62,6 +65,21 @@ supported hash on the backend or you can use a named hash such as
 
 Use "rclone hashsum" to see the full list.
 
+If this flag is set then the hash is also sent in an "OC-Checksum"
+header in responses to GET and HEAD requests if it is one of MD5,
+SHA-1 or Adler-32, so ownCloud style clients can verify downloads.
+
+#### Checksums
+
+Clients can ask for checksums of files by sending a "Want-Digest"
+header (RFC 3230) with a GET or HEAD request, eg
+
+    Want-Digest: adler32, md5
+
+rclone will reply with a "Digest" header containing whichever of
+"md5", "sha" (SHA-1) and "adler32" the remote can supply. Algorithms
+with a quality value of 0, eg "md5;q=0", are not sent.
+
 ` + httplib.Help + vfs.Help + proxy.Help,
 	RunE: func(command *cobra.Command, args []string) error {
 		var f fs.Fs
@@ -180,9 +198,125 @@ func (w *WebDAV) handler(rw http.ResponseWriter, r *http.Request) {
 		w.serveDir(rw, r, remote)
 		return
 	}
+	if (r.Method == "GET" || r.Method == "HEAD") && !isDir {
+		w.checksumHeaders(rw, r, remote)
+	}
 	w.webdavhandler.ServeHTTP(rw, r)
 }
 
+// digestAlgorithms are the RFC 3230 digest algorithms which can be
+// made from rclone hashes
+var digestAlgorithms = []struct {
+	name     string
+	hashType hash.Type
+	base64   bool // if set the digest is base64 encoded rather than hex
+}{
+	{name: "md5", hashType: hash.MD5, base64: true},
+	{name: "sha", hashType: hash.SHA1, base64: true},
+	{name: "adler32", hashType: hash.Adler32},
+}
+
+// ocChecksumNames are the names used in the OC-Checksum header for
+// rclone hashes
+var ocChecksumNames = map[hash.Type]string{
+	hash.MD5:     "MD5",
+	hash.SHA1:    "SHA1",
+	hash.Adler32: "ADLER32",
+}
+
+// checksumHeaders adds checksum headers for the file at remote to the
+// response.
+//
+// The Digest header is sent with the algorithms asked for in the
+// Want-Digest header and the OC-Checksum header is sent with the
+// --etag-hash if set.
+func (w *WebDAV) checksumHeaders(rw http.ResponseWriter, r *http.Request, remote string) {
+	wantDigest := r.Header.Get("Want-Digest")
+	ocName, haveOCName := ocChecksumNames[hashType]
+	if wantDigest == "" && !haveOCName {
+		return
+	}
+	VFS, err := w.getVFS(r.Context())
+	if err != nil {
+		return
+	}
+	node, err := VFS.Stat(remote)
+	if err != nil {
+		return
+	}
+	o, ok := node.DirEntry().(fs.Object)
+	if !ok {
+		return
+	}
+	ctx := r.Context()
+	if wantDigest != "" {
+		if digest := digestHeader(ctx, o, wantDigest); digest != "" {
+			rw.Header().Set("Digest", digest)
+		}
+	}
+	if haveOCName {
+		sum, err := o.Hash(ctx, hashType)
+		if err == nil && sum != "" {
+			rw.Header().Set("OC-Checksum", ocName+":"+sum)
+		}
+	}
+}
+
+// digestHeader returns the value for a Digest header for o containing
+// the algorithms in wantDigest which o can supply.
+//
+// Algorithms with a quality value of 0, eg "md5;q=0", aren't sent and
+// each algorithm is only sent once.
+//
+// It returns an empty string if none of them can be supplied.
+func digestHeader(ctx context.Context, o fs.Object, wantDigest string) string {
+	var digests []string
+	seen := map[string]bool{}
+	for _, want := range strings.Split(wantDigest, ",") {
+		params := strings.Split(want, ";")
+		want = strings.ToLower(strings.TrimSpace(params[0]))
+		if seen[want] || !wantedDigest(params[1:]) {
+			continue
+		}
+		seen[want] = true
+		for _, algorithm := range digestAlgorithms {
+			if algorithm.name != want {
+				continue
+			}
+			sum, err := o.Hash(ctx, algorithm.hashType)
+			if err != nil || sum == "" {
+				break
+			}
+			if algorithm.base64 {
+				raw, err := hex.DecodeString(sum)
+				if err != nil {
+					break
+				}
+				sum = base64.StdEncoding.EncodeToString(raw)
+			}
+			digests = append(digests, algorithm.name+"="+sum)
+			break
+		}
+	}
+	return strings.Join(digests, ",")
+}
+
+// wantedDigest returns false if the Want-Digest parameters in params
+// have a quality value of 0 meaning the algorithm mustn't be sent
+func wantedDigest(params []string) bool {
+	for _, param := range params {
+		param = strings.TrimSpace(param)
+		if len(param) < 2 || strings.ToLower(param[:2]) != "q=" {
+			continue
+		}
+		q, err := strconv.ParseFloat(param[2:], 64)
+		if err == nil && q <= 0 {
+			return false
+		}
+	}
+	return true
+}
+
 // serveDir serves a directory index at dirRemote
 // This is similar to serveDir in serve http.
 func (w *WebDAV) serveDir(rw http.ResponseWriter, r *http.Request, dirRemote string) {
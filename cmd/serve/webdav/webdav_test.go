@@ -8,6 +8,7 @@
 package webdav
 
 import (
+	"context"
 	"flag"
 	"io/ioutil"
 	"net/http"
@@ -24,6 +25,7 @@ import (
 	"github.com/rclone/rclone/fs/config/obscure"
 	"github.com/rclone/rclone/fs/filter"
 	"github.com/rclone/rclone/fs/hash"
+	"github.com/rclone/rclone/fstest/mockobject"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/net/webdav"
@@ -255,3 +257,105 @@ func HelpTestGET(t *testing.T, testURL string) {
 		checkGolden(t, test.Golden, body)
 	}
 }
+
+func TestDigestHeader(t *testing.T) {
+	ctx := context.Background()
+	o := mockobject.New("two.txt").WithContent([]byte("0123456789\n"), mockobject.SeekModeNone)
+	for _, test := range []struct {
+		wantDigest string
+		want       string
+	}{
+		{wantDigest: "adler32", want: "adler32=0d170218"},
+		{wantDigest: "MD5", want: "md5=N0n1K7MmrpZ4K0LcCpe0wQ=="},
+		{wantDigest: "sha;q=0.5, adler32;q=1", want: "sha=OjCUj4zVZV/t44nXO1/s2RJR30o=,adler32=0d170218"},
+		{wantDigest: "crc32c, adler32", want: "adler32=0d170218"},
+		{wantDigest: "unixsum", want: ""},
+		{wantDigest: "md5;q=0, adler32", want: "adler32=0d170218"},
+		{wantDigest: "md5;q=0.0", want: ""},
+		{wantDigest: "md5, MD5;q=0.5, md5", want: "md5=N0n1K7MmrpZ4K0LcCpe0wQ=="},
+	} {
+		got := digestHeader(ctx, o, test.wantDigest)
+		assert.Equal(t, test.want, got, test.wantDigest)
+	}
+}
+
+func TestChecksumHeaders(t *testing.T) {
+	f, err := fs.NewFs("../http/testdata/files")
+	require.NoError(t, err)
+
+	oldHashType := hashType
+	defer func() {
+		hashType = oldHashType
+	}()
+	hashType = hash.MD5
+
+	opt := httplib.DefaultOpt
+	opt.ListenAddr = testBindAddress
+	opt.Template = testTemplate
+
+	// Start the server
+	w := newWebDAV(f, &opt)
+	require.NoError(t, w.serve())
+	defer func() {
+		w.Close()
+		w.Wait()
+	}()
+	testURL := w.Server.URL()
+
+	for _, test := range []struct {
+		URL        string
+		Method     string
+		WantDigest string
+		Digest     string
+		OCChecksum string
+	}{
+		{
+			URL:        "two.txt",
+			Method:     "GET",
+			WantDigest: "adler32, md5",
+			Digest:     "adler32=0d170218,md5=N0n1K7MmrpZ4K0LcCpe0wQ==",
+			OCChecksum: "MD5:3749f52bb326ae96782b42dc0a97b4c1",
+		},
+		{
+			URL:        "two.txt",
+			Method:     "HEAD",
+			WantDigest: "sha",
+			Digest:     "sha=OjCUj4zVZV/t44nXO1/s2RJR30o=",
+			OCChecksum: "MD5:3749f52bb326ae96782b42dc0a97b4c1",
+		},
+		{
+			URL:        "two.txt",
+			Method:     "GET",
+			OCChecksum: "MD5:3749f52bb326ae96782b42dc0a97b4c1",
+		},
+		{
+			URL:        "two.txt",
+			Method:     "PROPFIND",
+			WantDigest: "md5",
+		},
+		{
+			URL:        "three/",
+			Method:     "GET",
+			WantDigest: "md5",
+		},
+		{
+			URL:        "three",
+			Method:     "HEAD",
+			WantDigest: "md5",
+		},
+	} {
+		what := test.Method + " " + test.URL
+		req, err := http.NewRequest(test.Method, testURL+test.URL, nil)
+		require.NoError(t, err)
+		if test.WantDigest != "" {
+			req.Header.Set("Want-Digest", test.WantDigest)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		_, err = ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		assert.Equal(t, test.Digest, resp.Header.Get("Digest"), what)
+		assert.Equal(t, test.OCChecksum, resp.Header.Get("OC-Checksum"), what)
+	}
+}
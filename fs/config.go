@@ -50,11 +50,13 @@ type ConfigInfo struct {
 	IgnoreTimes            bool
 	IgnoreExisting         bool
 	IgnoreErrors           bool
+	IgnoreListErrors       bool // Skip directories which fail to list
 	ModifyWindow           time.Duration
 	Checkers               int
 	Transfers              int
 	ConnectTimeout         time.Duration // Connect timeout
 	Timeout                time.Duration // Data channel timeout
+	TransferTimeout        time.Duration // Max time for a single file transfer
 	ExpectContinueTimeout  time.Duration
 	Dump                   DumpFlags
 	InsecureSkipVerify     bool // Skip server certificate verification
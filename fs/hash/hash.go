@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"hash"
+	"hash/adler32"
 	"hash/crc32"
 	"io"
 	"strings"
@@ -60,6 +61,9 @@ var (
 
 	// CRC32 indicates CRC-32 support
 	CRC32 Type
+
+	// Adler32 indicates Adler-32 support
+	Adler32 Type
 )
 
 func init() {
@@ -67,6 +71,7 @@ func init() {
 	SHA1 = RegisterHash("SHA-1", 40, sha1.New)
 	Whirlpool = RegisterHash("Whirlpool", 128, whirlpool.New)
 	CRC32 = RegisterHash("CRC-32", 8, func() hash.Hash { return crc32.NewIEEE() })
+	Adler32 = RegisterHash("Adler-32", 8, func() hash.Hash { return adler32.New() })
 }
 
 // Supported returns a set of all the supported hashes by
@@ -144,6 +149,15 @@ func (h *Type) Set(s string) error {
 		}
 	}
 
+	// Allow case insensitive matches with the "-" left out, eg
+	// "sha1" for "SHA-1"
+	for _, v := range hashes {
+		if strings.EqualFold(strings.Replace(v.name, "-", "", -1), strings.Replace(s, "-", "", -1)) {
+			*h = v.hashType
+			return nil
+		}
+	}
+
 	return errors.Errorf("Unknown hash type %q", s)
 }
 
@@ -3,6 +3,7 @@ package march
 
 import (
 	"context"
+	"fmt"
 	"path"
 	"sort"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"github.com/rclone/rclone/fs"
 	"github.com/rclone/rclone/fs/dirtree"
 	"github.com/rclone/rclone/fs/filter"
+	"github.com/rclone/rclone/fs/fserrors"
 	"github.com/rclone/rclone/fs/list"
 	"github.com/rclone/rclone/fs/walk"
 	"golang.org/x/text/unicode/norm"
@@ -36,6 +38,8 @@ type March struct {
 	srcListDir listDirFn // function to call to list a directory in the src
 	dstListDir listDirFn // function to call to list a directory in the dst
 	transforms []matchTransformFn
+	skippedMu  sync.Mutex       // protects skipped
+	skipped    map[string]error // directories skipped because of --ignore-list-errors
 }
 
 // Marcher is called on each match
@@ -98,8 +102,10 @@ func (m *March) makeListDir(f fs.Fs, includeAll bool) listDirFn {
 			dirs, dirsErr = walk.NewDirTree(m.Ctx, f, m.Dir, includeAll, fs.Config.MaxDepth)
 			started = true
 		}
-		if dirsErr != nil {
+		if dirsErr == fs.ErrorDirNotFound {
 			return nil, dirsErr
+		} else if dirsErr != nil {
+			return nil, dirTreeError{dirsErr}
 		}
 		entries, ok := dirs[dir]
 		if !ok {
@@ -111,6 +117,17 @@ func (m *March) makeListDir(f fs.Fs, includeAll bool) listDirFn {
 	}
 }
 
+// dirTreeError is returned by the --fast-list listing function when
+// the whole directory tree couldn't be read
+type dirTreeError struct {
+	error
+}
+
+// Cause returns the underlying error
+func (err dirTreeError) Cause() error {
+	return err.error
+}
+
 // listDirJob describe a directory listing that needs to be done
 type listDirJob struct {
 	srcRemote string
@@ -211,6 +228,86 @@ func (m *March) Run() error {
 	return jobError
 }
 
+// skipDir records dir as skipped because listing it returned err
+//
+// Neither the source nor the destination of a skipped directory are
+// traversed so nothing inside it will be transferred or deleted.
+func (m *March) skipDir(dir string, err error) {
+	m.skippedMu.Lock()
+	if m.skipped == nil {
+		m.skipped = make(map[string]error)
+	}
+	m.skipped[dir] = err
+	m.skippedMu.Unlock()
+}
+
+// Skipped returns the directories which were skipped because they
+// couldn't be listed with --ignore-list-errors, along with the error
+// listing them.
+//
+// It should be called after Run has returned.
+func (m *March) Skipped() map[string]error {
+	m.skippedMu.Lock()
+	defer m.skippedMu.Unlock()
+	if len(m.skipped) == 0 {
+		return nil
+	}
+	skipped := make(map[string]error, len(m.skipped))
+	for dir, err := range m.skipped {
+		skipped[dir] = err
+	}
+	return skipped
+}
+
+// LogSkipped logs a summary of the directories which were skipped
+// because they couldn't be listed with --ignore-list-errors.
+//
+// It should be called at the end of the run, after any transfers
+// have finished, so the summary doesn't get lost in the log.
+func (m *March) LogSkipped() {
+	skipped := m.Skipped()
+	if len(skipped) == 0 {
+		return
+	}
+	dirs := make([]string, 0, len(skipped))
+	for dir := range skipped {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	var out strings.Builder
+	for _, dir := range dirs {
+		name := dir
+		if name == "" {
+			name = "/"
+		}
+		_, _ = fmt.Fprintf(&out, "\n  %s: %v", name, skipped[dir])
+	}
+	fs.Errorf(nil, "Skipped %d directories which couldn't be listed:%s", len(dirs), out.String())
+}
+
+// canSkipListError returns true if the error listing the directory
+// for job can be skipped because --ignore-list-errors is set
+//
+// Only errors which retrying won't fix are skipped. The root of the
+// march and a failed --fast-list listing are never skipped as that
+// would skip the whole tree. Fatal errors and errors caused by the
+// march being cancelled are never skipped either.
+func (m *March) canSkipListError(job listDirJob, err error) bool {
+	if !fs.Config.IgnoreListErrors || job.srcRemote == m.Dir || m.aborting() {
+		return false
+	}
+	if _, ok := err.(dirTreeError); ok {
+		return false
+	}
+	if _, cause := fserrors.Cause(err); cause == context.Canceled {
+		return false
+	}
+	if fserrors.IsFatalError(err) {
+		return false
+	}
+	return !fserrors.ShouldRetry(err) && !fserrors.IsRetryError(err)
+}
+
 // Check to see if the context has been cancelled
 func (m *March) aborting() bool {
 	select {
@@ -398,6 +495,10 @@ func (m *March) processJob(job listDirJob) ([]listDirJob, error) {
 	wg.Wait()
 	if srcListErr != nil {
 		fs.Errorf(job.srcRemote, "error reading source directory: %v", srcListErr)
+		if m.canSkipListError(job, srcListErr) {
+			m.skipDir(job.srcRemote, srcListErr)
+			return nil, nil
+		}
 		srcListErr = fs.CountError(srcListErr)
 		return nil, srcListErr
 	}
@@ -405,6 +506,10 @@ func (m *March) processJob(job listDirJob) ([]listDirJob, error) {
 		// Copy the stuff anyway
 	} else if dstListErr != nil {
 		fs.Errorf(job.dstRemote, "error reading destination directory: %v", dstListErr)
+		if m.canSkipListError(job, dstListErr) {
+			m.skipDir(job.dstRemote, dstListErr)
+			return nil, nil
+		}
 		dstListErr = fs.CountError(dstListErr)
 		return nil, dstListErr
 	}
@@ -4,14 +4,16 @@ package march
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"io"
 	"strings"
 	"sync"
 	"testing"
 
+	"github.com/pkg/errors"
 	_ "github.com/rclone/rclone/backend/local"
 	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/accounting"
 	"github.com/rclone/rclone/fs/filter"
 	"github.com/rclone/rclone/fs/fserrors"
 	"github.com/rclone/rclone/fstest"
@@ -529,3 +531,154 @@ func TestMatchListings(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessJobListErrors(t *testing.T) {
+	listErr := errors.New("list failed")
+	listOK := func(dir string) (fs.DirEntries, error) {
+		return nil, nil
+	}
+	listFail := func(err error) listDirFn {
+		return func(dir string) (fs.DirEntries, error) {
+			return nil, err
+		}
+	}
+	oldIgnoreListErrors := fs.Config.IgnoreListErrors
+	defer func() {
+		fs.Config.IgnoreListErrors = oldIgnoreListErrors
+		accounting.GlobalStats().ResetCounters()
+	}()
+	for _, test := range []struct {
+		what       string
+		srcListDir listDirFn
+		dstListDir listDirFn
+		dir        string
+		err        error
+		skip       bool
+		aborting   bool
+	}{
+		{what: "src", srcListDir: listFail(listErr), dstListDir: listOK, dir: "dir", err: listErr, skip: true},
+		{what: "dst", srcListDir: listOK, dstListDir: listFail(listErr), dir: "dir", err: listErr, skip: true},
+		{what: "root", srcListDir: listFail(listErr), dstListDir: listOK, dir: "", err: listErr},
+		{what: "transient", srcListDir: listFail(io.ErrUnexpectedEOF), dstListDir: listOK, dir: "dir", err: io.ErrUnexpectedEOF},
+		{what: "retry", srcListDir: listFail(fserrors.RetryError(listErr)), dstListDir: listOK, dir: "dir", err: listErr},
+		{what: "fast-list", srcListDir: listFail(dirTreeError{listErr}), dstListDir: listOK, dir: "dir", err: listErr},
+		{what: "fatal", srcListDir: listFail(fserrors.FatalError(listErr)), dstListDir: listOK, dir: "dir", err: listErr},
+		{what: "canceled", srcListDir: listFail(errors.Wrap(context.Canceled, "list")), dstListDir: listOK, dir: "dir", err: errors.Wrap(context.Canceled, "list")},
+		{what: "aborting", srcListDir: listFail(listErr), dstListDir: listOK, dir: "dir", err: listErr, aborting: true},
+	} {
+		t.Run(test.what, func(t *testing.T) {
+			accounting.GlobalStats().ResetCounters()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if test.aborting {
+				cancel()
+			}
+			m := &March{
+				Ctx:        ctx,
+				srcListDir: test.srcListDir,
+				dstListDir: test.dstListDir,
+			}
+			job := listDirJob{srcRemote: test.dir, dstRemote: test.dir}
+
+			fs.Config.IgnoreListErrors = false
+			jobs, err := m.processJob(job)
+			assert.EqualError(t, err, test.err.Error())
+			assert.Nil(t, jobs)
+			assert.Nil(t, m.Skipped())
+			assert.Equal(t, int64(1), accounting.GlobalStats().GetErrors())
+
+			fs.Config.IgnoreListErrors = true
+			jobs, err = m.processJob(job)
+			assert.Nil(t, jobs)
+			if test.skip {
+				assert.NoError(t, err)
+				assert.Equal(t, map[string]error{test.dir: test.err}, m.Skipped())
+				assert.Equal(t, int64(1), accounting.GlobalStats().GetErrors())
+			} else {
+				assert.EqualError(t, err, test.err.Error())
+				assert.Nil(t, m.Skipped())
+				assert.Equal(t, int64(2), accounting.GlobalStats().GetErrors())
+			}
+		})
+	}
+}
+
+// listErrorFs is an fs.Fs which can't list the directories in errDirs
+type listErrorFs struct {
+	fs.Fs
+	errDirs map[string]error
+}
+
+// List the objects and directories in dir into entries
+func (f listErrorFs) List(ctx context.Context, dir string) (fs.DirEntries, error) {
+	if err := f.errDirs[dir]; err != nil {
+		return nil, err
+	}
+	return f.Fs.List(ctx, dir)
+}
+
+func TestMarchIgnoreListErrors(t *testing.T) {
+	r := fstest.NewRun(t)
+	defer r.Finalise()
+	oldIgnoreListErrors := fs.Config.IgnoreListErrors
+	oldLogPrint := fs.LogPrint
+	defer func() {
+		fs.Config.IgnoreListErrors = oldIgnoreListErrors
+		fs.LogPrint = oldLogPrint
+		accounting.GlobalStats().ResetCounters()
+	}()
+	var logMu sync.Mutex
+	var logs []string
+	fs.LogPrint = func(level fs.LogLevel, text string) {
+		logMu.Lock()
+		logs = append(logs, text)
+		logMu.Unlock()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	file1 := r.WriteFile("file1", "hello world", t1)
+	r.WriteFile("a/file2", "hello world", t1)
+	r.WriteFile("b/c/file3", "hello world", t1)
+	fsrc := listErrorFs{
+		Fs: r.Flocal,
+		errDirs: map[string]error{
+			"a":   errors.New("permission denied"),
+			"b/c": errors.New("corrupt entry"),
+		},
+	}
+
+	fs.Config.IgnoreListErrors = true
+	accounting.GlobalStats().ResetCounters()
+	mt := &marchTester{
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	m := &March{
+		Ctx:      ctx,
+		Fdst:     r.Fremote,
+		Fsrc:     fsrc,
+		Callback: mt,
+	}
+	mt.processError(m.Run())
+	mt.cancel()
+	require.NoError(t, mt.currentError())
+	assert.Equal(t, int64(0), accounting.GlobalStats().GetErrors())
+	for _, log := range logs {
+		assert.NotContains(t, log, "Skipped", "summary logged by Run")
+	}
+
+	precision := fs.GetModifyWindow(r.Fremote, r.Flocal)
+	fstest.CompareItems(t, mt.srcOnly, []fstest.Item{file1}, []string{"a", "b", "b/c"}, precision, "srcOnly")
+	assert.Equal(t, map[string]error{
+		"a":   fsrc.errDirs["a"],
+		"b/c": fsrc.errDirs["b/c"],
+	}, m.Skipped())
+
+	// Check Skipped returns a copy
+	delete(m.Skipped(), "a")
+	assert.Len(t, m.Skipped(), 2)
+
+	m.LogSkipped()
+	require.NotEmpty(t, logs)
+	assert.Equal(t, "Skipped 2 directories which couldn't be listed:\n  a: permission denied\n  b/c: corrupt entry", logs[len(logs)-1])
+}
@@ -220,6 +220,7 @@ func CheckFn(ctx context.Context, opt *CheckOpt) error {
 	fs.Debugf(c.opt.Fdst, "Waiting for checks to finish")
 	err := m.Run()
 	c.wg.Wait() // wait for background go-routines
+	m.LogSkipped()
 
 	if c.dstFilesMissing > 0 {
 		fs.Logf(c.opt.Fdst, "%d files missing", c.dstFilesMissing)
@@ -16,6 +16,18 @@ const (
 	multithreadBufferSize    = 32 * 1024
 )
 
+// partialCopyError is returned by multiThreadCopy when it fails after
+// it has started writing to the destination so a partially written
+// file may have been left there
+type partialCopyError struct {
+	error
+}
+
+// Cause returns the underlying error
+func (err partialCopyError) Cause() error {
+	return err.error
+}
+
 // Return a boolean as to whether we should use multi thread copy for
 // this transfer
 func doMultiThreadCopy(f fs.Fs, src fs.Object) bool {
@@ -176,10 +188,10 @@ func multiThreadCopy(ctx context.Context, f fs.Fs, remote string, src fs.Object,
 	err = g.Wait()
 	closeErr := mc.wc.Close()
 	if err != nil {
-		return nil, err
+		return nil, partialCopyError{err}
 	}
 	if closeErr != nil {
-		return nil, errors.Wrap(closeErr, "multi-thread copy: failed to close object after copy")
+		return nil, partialCopyError{errors.Wrap(closeErr, "multi-thread copy: failed to close object after copy")}
 	}
 
 	obj, err := f.NewObject(ctx, remote)
@@ -365,10 +365,15 @@ func Copy(ctx context.Context, f fs.Fs, dst fs.Object, remote string, src fs.Obj
 			(fs.Config.CutoffMode == fs.CutoffModeCautious && accounting.Stats(ctx).GetBytesWithPending()+src.Size() >= int64(fs.Config.MaxTransfer))) {
 			return nil, accounting.ErrorMaxTransferLimitReachedFatal
 		}
+		// Limit the time this attempt at the transfer can take
+		tryCtx, tryCancel := ctx, func() {}
+		if fs.Config.TransferTimeout > 0 {
+			tryCtx, tryCancel = context.WithTimeout(ctx, fs.Config.TransferTimeout)
+		}
 		if doCopy := f.Features().Copy; doCopy != nil && (SameConfig(src.Fs(), f) || (SameRemoteType(src.Fs(), f) && f.Features().ServerSideAcrossConfigs)) {
 			in := tr.Account(nil) // account the transfer
 			in.ServerSideCopyStart()
-			newDst, err = doCopy(ctx, src, remote)
+			newDst, err = doCopy(tryCtx, src, remote)
 			if err == nil {
 				dst = newDst
 				in.ServerSideCopyEnd(dst.Size()) // account the bytes for the server side transfer
@@ -394,7 +399,7 @@ func Copy(ctx context.Context, f fs.Fs, dst fs.Object, remote string, src fs.Obj
 				if streams < 2 {
 					streams = 2
 				}
-				dst, err = multiThreadCopy(ctx, f, remote, src, int(streams), tr)
+				dst, err = multiThreadCopy(tryCtx, f, remote, src, int(streams), tr)
 				if doUpdate {
 					actionTaken = "Multi-thread Copied (replaced existing)"
 				} else {
@@ -406,7 +411,7 @@ func Copy(ctx context.Context, f fs.Fs, dst fs.Object, remote string, src fs.Obj
 				for _, option := range fs.Config.DownloadHeaders {
 					options = append(options, option)
 				}
-				in0, err = NewReOpen(ctx, src, fs.Config.LowLevelRetries, options...)
+				in0, err = NewReOpen(tryCtx, src, fs.Config.LowLevelRetries, options...)
 				if err != nil {
 					err = errors.Wrap(err, "failed to open source object")
 				} else {
@@ -418,7 +423,7 @@ func Copy(ctx context.Context, f fs.Fs, dst fs.Object, remote string, src fs.Obj
 							actionTaken = "Copied (Rcat, new)"
 						}
 						// NB Rcat closes in0
-						dst, err = Rcat(ctx, f, remote, in0, src.ModTime(ctx))
+						dst, err = Rcat(tryCtx, f, remote, in0, src.ModTime(ctx))
 						newDst = dst
 					} else {
 						in := tr.Account(in0).WithBuffer() // account and buffer the transfer
@@ -433,10 +438,10 @@ func Copy(ctx context.Context, f fs.Fs, dst fs.Object, remote string, src fs.Obj
 						}
 						if doUpdate {
 							actionTaken = "Copied (replaced existing)"
-							err = dst.Update(ctx, in, wrappedSrc, options...)
+							err = dst.Update(tryCtx, in, wrappedSrc, options...)
 						} else {
 							actionTaken = "Copied (new)"
-							dst, err = f.Put(ctx, in, wrappedSrc, options...)
+							dst, err = f.Put(tryCtx, in, wrappedSrc, options...)
 						}
 						closeErr := in.Close()
 						if err == nil {
@@ -447,6 +452,20 @@ func Copy(ctx context.Context, f fs.Fs, dst fs.Object, remote string, src fs.Obj
 				}
 			}
 		}
+		if err != nil && tryCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+			// A multi-thread copy writes to the destination in place
+			// so remove what it left behind. Otherwise the backend
+			// is responsible for cleaning up a failed upload and
+			// any object at remote may be one we didn't touch.
+			if _, isPartial := err.(partialCopyError); isPartial {
+				if partial, findErr := f.NewObject(ctx, remote); findErr == nil {
+					removeFailedCopy(ctx, partial)
+				}
+			}
+			// Don't low level retry - leave it to --retries
+			err = fserrors.NoLowLevelRetryError(errors.Errorf("transfer timed out after --transfer-timeout %v: %v", fs.Config.TransferTimeout, err))
+		}
+		tryCancel()
 		tries++
 		if tries >= maxTries {
 			break
@@ -1459,3 +1459,97 @@ func TestCopyFileMaxTransfer(t *testing.T) {
 	fstest.CheckItems(t, r.Flocal, file1, file2, file3, file4)
 	fstest.CheckItems(t, r.Fremote, file1, file4)
 }
+
+// blockingPutFs is an Fs whose Put blocks until its context is done
+// without touching the destination like a backend with atomic uploads
+type blockingPutFs struct {
+	fs.Fs
+	puts int
+}
+
+func (f *blockingPutFs) Put(ctx context.Context, in io.Reader, src fs.ObjectInfo, options ...fs.OpenOption) (fs.Object, error) {
+	f.puts++
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+// blockingOpenObject is an Object whose Open blocks until its context
+// is done
+type blockingOpenObject struct {
+	fs.Object
+}
+
+func (o blockingOpenObject) Open(ctx context.Context, options ...fs.OpenOption) (io.ReadCloser, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestCopyTransferTimeout(t *testing.T) {
+	ctx := context.Background()
+	r := fstest.NewRun(t)
+	defer r.Finalise()
+	oldTransferTimeout := fs.Config.TransferTimeout
+	oldLowLevelRetries := fs.Config.LowLevelRetries
+	oldNoCheckDest := fs.Config.NoCheckDest
+	defer func() {
+		fs.Config.TransferTimeout = oldTransferTimeout
+		fs.Config.LowLevelRetries = oldLowLevelRetries
+		fs.Config.NoCheckDest = oldNoCheckDest
+		accounting.Stats(ctx).ResetCounters()
+	}()
+	fs.Config.TransferTimeout = 100 * time.Millisecond
+	fs.Config.LowLevelRetries = 2
+	fs.Config.NoCheckDest = true
+
+	file1 := r.WriteFile("file1", "file1 contents", t1)
+	src, err := r.Flocal.NewObject(ctx, file1.Path)
+	require.NoError(t, err)
+	original := r.WriteObject(ctx, "file1", "original", t2)
+
+	// With --no-check-dest the existing object isn't passed in so
+	// it must survive the timed out Put and the transfer isn't low
+	// level retried
+	f := &blockingPutFs{Fs: r.Fremote}
+	_, err = operations.Copy(ctx, f, nil, file1.Path, src)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--transfer-timeout")
+	assert.True(t, fserrors.IsNoLowLevelRetryError(err))
+	assert.False(t, fserrors.IsRetryError(err))
+	assert.Equal(t, 1, f.puts)
+	fstest.CheckItems(t, r.Fremote, original)
+}
+
+func TestCopyTransferTimeoutMultiThread(t *testing.T) {
+	ctx := context.Background()
+	r := fstest.NewRun(t)
+	defer r.Finalise()
+	if r.Fremote.Features().OpenWriterAt == nil {
+		t.Skip("Can't test without OpenWriterAt")
+	}
+	oldTransferTimeout := fs.Config.TransferTimeout
+	oldMultiThreadCutoff := fs.Config.MultiThreadCutoff
+	oldMultiThreadStreams := fs.Config.MultiThreadStreams
+	oldMultiThreadSet := fs.Config.MultiThreadSet
+	defer func() {
+		fs.Config.TransferTimeout = oldTransferTimeout
+		fs.Config.MultiThreadCutoff = oldMultiThreadCutoff
+		fs.Config.MultiThreadStreams = oldMultiThreadStreams
+		fs.Config.MultiThreadSet = oldMultiThreadSet
+		accounting.Stats(ctx).ResetCounters()
+	}()
+	fs.Config.TransferTimeout = 100 * time.Millisecond
+	fs.Config.MultiThreadCutoff = 1
+	fs.Config.MultiThreadStreams = 2
+	fs.Config.MultiThreadSet = true
+
+	file1 := r.WriteFile("file1", "file1 contents", t1)
+	src, err := r.Flocal.NewObject(ctx, file1.Path)
+	require.NoError(t, err)
+
+	// The destination is allocated at full size before the source
+	// is read so it must be removed on timeout
+	_, err = operations.Copy(ctx, r.Fremote, nil, file1.Path, blockingOpenObject{src})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--transfer-timeout")
+	fstest.CheckItems(t, r.Fremote)
+}
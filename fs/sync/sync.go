@@ -808,6 +808,17 @@ func (s *syncCopyMove) run() error {
 	}
 	s.processError(m.Run())
 
+	// Directories which couldn't be listed weren't traversed so they
+	// mustn't be created or removed as if they were empty
+	for dir := range m.Skipped() {
+		s.srcEmptyDirsMu.Lock()
+		delete(s.srcEmptyDirs, dir)
+		s.srcEmptyDirsMu.Unlock()
+		s.dstEmptyDirsMu.Lock()
+		delete(s.dstEmptyDirs, dir)
+		s.dstEmptyDirsMu.Unlock()
+	}
+
 	s.stopTrackRenames()
 	if s.trackRenames {
 		// Build the map of the remaining dstFiles by hash
@@ -863,6 +874,10 @@ func (s *syncCopyMove) run() error {
 	// Read the error out of the context if there is one
 	s.processError(s.ctx.Err())
 
+	// Now the transfers have finished summarise the directories
+	// skipped with --ignore-list-errors
+	m.LogSkipped()
+
 	if s.deleteMode != fs.DeleteModeOnly && accounting.Stats(s.ctx).GetTransfers() == 0 {
 		fs.Infof(nil, "There was nothing to transfer")
 	}
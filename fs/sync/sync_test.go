@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"runtime"
 	"strings"
+	gosync "sync"
 	"testing"
 	"time"
 
@@ -931,6 +932,89 @@ func TestSyncWithExclude(t *testing.T) {
 	fstest.CheckItems(t, r.Flocal, file2, file1, file3)
 }
 
+// listErrorFs is an fs.Fs which can't list the directories in errDirs
+type listErrorFs struct {
+	fs.Fs
+	errDirs map[string]bool
+}
+
+// List the objects and directories in dir into entries
+func (f listErrorFs) List(ctx context.Context, dir string) (fs.DirEntries, error) {
+	if f.errDirs[dir] {
+		return nil, errors.New("permission denied")
+	}
+	return f.Fs.List(ctx, dir)
+}
+
+// Test sync with --ignore-list-errors leaves directories which can't
+// be listed alone and carries on with the rest
+func TestSyncIgnoreListErrors(t *testing.T) {
+	ctx := context.Background()
+	r := fstest.NewRun(t)
+	defer r.Finalise()
+	file1 := r.WriteFile("file1", "file1", t1)
+	r.WriteFile("a/file2", "file2", t1)
+	r.WriteFile("b/file3", "file3", t1)
+	file4 := r.WriteObject(ctx, "a/file4", "file4", t2)
+	file5 := r.WriteObject(ctx, "file5", "file5", t2)
+	fstest.CheckItems(t, r.Fremote, file4, file5)
+	fsrc := listErrorFs{Fs: r.Flocal, errDirs: map[string]bool{"a": true, "b": true}}
+
+	// Without the flag the sync fails and nothing is deleted
+	accounting.GlobalStats().ResetCounters()
+	err := Sync(ctx, r.Fremote, fsrc, false)
+	require.Error(t, err)
+	fstest.CheckItems(t, r.Fremote, file1, file4, file5)
+
+	oldLogLevel := fs.Config.LogLevel
+	oldLogPrint := fs.LogPrint
+	fs.Config.IgnoreListErrors = true
+	defer func() {
+		fs.Config.IgnoreListErrors = false
+		fs.Config.LogLevel = oldLogLevel
+		fs.LogPrint = oldLogPrint
+	}()
+	var logMu gosync.Mutex
+	var logs []string
+	fs.Config.LogLevel = fs.LogLevelInfo
+	fs.LogPrint = func(level fs.LogLevel, text string) {
+		logMu.Lock()
+		logs = append(logs, text)
+		logMu.Unlock()
+	}
+
+	// With the flag file5 is deleted but a/file4 is left alone and
+	// b isn't created as an empty directory
+	accounting.GlobalStats().ResetCounters()
+	err = Sync(ctx, r.Fremote, fsrc, true)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), accounting.GlobalStats().GetErrors())
+
+	// The summary of skipped directories comes after the deletes
+	deleted, summary := -1, -1
+	for i, log := range logs {
+		if strings.HasPrefix(log, "file5: Deleted") {
+			deleted = i
+		} else if strings.HasPrefix(log, "Skipped 2 directories which couldn't be listed:") {
+			summary = i
+		}
+	}
+	assert.NotEqual(t, -1, deleted, "no delete logged")
+	assert.Greater(t, summary, deleted, "summary not logged after delete")
+	fstest.CheckListingWithPrecision(
+		t,
+		r.Fremote,
+		[]fstest.Item{
+			file1,
+			file4,
+		},
+		[]string{
+			"a",
+		},
+		fs.GetModifyWindow(r.Fremote),
+	)
+}
+
 // Test with exclude and delete excluded
 func TestSyncWithExcludeAndDeleteExcluded(t *testing.T) {
 	r := fstest.NewRun(t)